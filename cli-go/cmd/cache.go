@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"llm-cli/internal/cache"
+)
+
+func newCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Inspect and manage the local chat response cache",
+	}
+	cmd.AddCommand(newCacheLsCmd())
+	cmd.AddCommand(newCacheRmCmd())
+	cmd.AddCommand(newCachePruneCmd())
+	cmd.AddCommand(newCacheStatsCmd())
+	return cmd
+}
+
+// openCache opens the cache store directly, independent of --cache, so
+// `llmcli cache ...` works even when the flag is off for other commands.
+func openCache() (*cache.Cache, error) {
+	return cache.Open(cache.DefaultDir(), cache.DefaultMaxBytes)
+}
+
+func newCacheLsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "ls",
+		Short: "List cached chat turns, oldest-accessed first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := openCache()
+			if err != nil {
+				return err
+			}
+			entries, err := c.List()
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				fmt.Printf("%s  %10d bytes  %s\n", e.Key, e.Size, e.ModTime.Format(time.RFC3339))
+			}
+			return nil
+		},
+	}
+}
+
+func newCacheRmCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rm <key>",
+		Short: "Remove one cached entry by key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := openCache()
+			if err != nil {
+				return err
+			}
+			return c.Remove(args[0])
+		},
+	}
+}
+
+func newCachePruneCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "prune",
+		Short: "Evict oldest-accessed entries until the cache is back under its size cap",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := openCache()
+			if err != nil {
+				return err
+			}
+			removed, err := c.Prune()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("removed %d entries\n", removed)
+			return nil
+		},
+	}
+}
+
+func newCacheStatsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stats",
+		Short: "Show cache entry count and size against its cap",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := openCache()
+			if err != nil {
+				return err
+			}
+			s, err := c.Stats()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%d entries, %d/%d bytes\n", s.Entries, s.Bytes, s.MaxBytes)
+			return nil
+		},
+	}
+}
@@ -2,11 +2,9 @@ package cmd
 
 import (
 	"context"
-	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
-
-	"llm-cli/internal/client"
 )
 
 func newCatCmd() *cobra.Command {
@@ -15,13 +13,20 @@ func newCatCmd() *cobra.Command {
 		Short: "Print a file from the VM",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			c := client.New(server)
-			content, err := c.ReadFile(context.Background(), user, args[0])
-			if err != nil {
-				return err
+			ctx := context.Background()
+			c := newClient()
+
+			var total int64
+			if info, err := c.Stat(ctx, user, args[0]); err == nil {
+				total = info.Size
+			}
+			progress, bar := newProgressFunc(total)
+
+			err := c.ReadFileStream(ctx, user, args[0], os.Stdout, progress)
+			if bar != nil {
+				bar.Finish()
 			}
-			fmt.Print(content)
-			return nil
+			return err
 		},
 	}
 }
@@ -1,17 +1,29 @@
 package cmd
 
 import (
-	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"strconv"
+	"strings"
 
+	"github.com/chzyer/readline"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
 	"llm-cli/internal/client"
 )
 
+// maxInlineToolOutput is how much of a tool_result's output is printed
+// inline before it's collapsed behind "..."; the full text stays
+// available via /show <n>.
+const maxInlineToolOutput = 200
+
 func newChatCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "chat",
@@ -21,9 +33,32 @@ func newChatCmd() *cobra.Command {
 	return cmd
 }
 
+// transcriptEntry is one exchange recorded for /save.
+type transcriptEntry struct {
+	prompt string
+	reply  strings.Builder
+}
+
+// chatSession holds everything runChat's slash-commands and event loop
+// share, so command handlers can be small top-level functions instead of
+// one sprawling closure.
+type chatSession struct {
+	ctx     context.Context
+	c       *client.Client
+	session string
+	model   string
+	system  string
+
+	toolOutputs []string
+	totalTokens int
+	transcript  []*transcriptEntry
+	lastPrompt  string
+	docHashes   []string // sha256 of every document /upload'd into this session
+}
+
 func runChat(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
-	c := client.New(server)
+	c := newClient()
 
 	sessions, err := c.ListSessions(ctx, user)
 	if err != nil {
@@ -46,38 +81,324 @@ func runChat(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	cyan := color.New(color.FgCyan).SprintFunc()
-	green := color.New(color.FgGreen).SprintFunc()
-	yellow := color.New(color.FgYellow).SprintFunc()
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:          promptFor(session, 0),
+		HistoryFile:     historyFilePath(user),
+		AutoComplete:    newCompleter(ctx, c),
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+	})
+	if err != nil {
+		return err
+	}
+	defer rl.Close()
 
-	fmt.Printf("Chatting as %s in session '%s'\n", green(user), session)
+	sess := &chatSession{ctx: ctx, c: c, session: session}
+
+	fmt.Printf("Chatting as %s in session '%s'\n", color.GreenString(user), session)
 
-	scanner := bufio.NewScanner(os.Stdin)
 	for {
-		fmt.Printf("%s> ", cyan("You"))
-		if !scanner.Scan() {
-			break
+		rl.SetPrompt(promptFor(sess.session, sess.totalTokens))
+		line, err := rl.Readline()
+		if err == readline.ErrInterrupt {
+			// A bare Ctrl-C with nothing in flight just clears the
+			// current line, matching shell-like REPLs; Ctrl-C during a
+			// stream is handled inside sendPrompt via its own context.
+			continue
 		}
-		line := scanner.Text()
-		if line == "exit" || line == "quit" {
+		if err == io.EOF || line == "exit" || line == "quit" {
 			break
 		}
-		stream, err := c.ChatStream(ctx, user, session, line)
 		if err != nil {
-			fmt.Println("error:", err)
+			return err
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
 			continue
 		}
-		r := bufio.NewReader(stream)
-		for {
-			part, err := r.ReadString('\n')
-			if len(part) > 0 {
-				fmt.Print(yellow(part))
-			}
-			if err != nil {
-				break
+
+		if strings.HasPrefix(line, "/") {
+			if handled, err := sess.handleSlashCommand(line); handled {
+				if err != nil {
+					fmt.Println("error:", err)
+				}
+				continue
 			}
 		}
-		stream.Close()
+
+		sess.sendPrompt(line)
 	}
 	return nil
 }
+
+func promptFor(session string, totalTokens int) string {
+	return fmt.Sprintf("%s[%s][%dtok]> ", color.CyanString("You"), session, totalTokens)
+}
+
+func historyFilePath(user string) string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".llmcli_history." + user
+	}
+	return filepath.Join(home, ".llmcli_history."+user)
+}
+
+// sendPrompt issues one chat turn against prompt and renders every
+// ChatEvent as it arrives. Ctrl-C during the stream cancels just this
+// turn via its own context rather than exiting the REPL; the read loop
+// is blocked on this call for the turn's duration, so there's no way
+// for a slash-command to reach handleSlashCommand and cancel it instead.
+func (s *chatSession) sendPrompt(prompt string) {
+	s.lastPrompt = prompt
+	full := prompt
+	if s.system != "" {
+		full = "System: " + s.system + "\n\n" + prompt
+	}
+
+	turnCtx, cancel := context.WithCancel(s.ctx)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+	go func() {
+		select {
+		case <-sigCh:
+			cancel()
+		case <-turnCtx.Done():
+		}
+	}()
+	defer cancel()
+
+	events, err := s.c.ChatStream(turnCtx, user, s.session, full, s.model, s.docHashes)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	entry := &transcriptEntry{prompt: prompt}
+	s.transcript = append(s.transcript, entry)
+
+	for ev := range events {
+		switch ev.Type {
+		case "token":
+			fmt.Print(color.YellowString(ev.Text))
+			entry.reply.WriteString(ev.Text)
+		case "tool_call":
+			fmt.Println(color.MagentaString("[tool] %s(%s)", ev.Tool.Name, ev.Tool.Args))
+		case "tool_result":
+			s.toolOutputs = append(s.toolOutputs, ev.Tool.Output)
+			fmt.Println(color.MagentaString("[tool result] %s -> %s (/show %d for full output)",
+				ev.Tool.Name, truncateToolOutput(ev.Tool.Output), len(s.toolOutputs)))
+		case "file_written":
+			fmt.Println(color.GreenString("[file written] " + ev.Path))
+		case "error":
+			fmt.Println(color.RedString("error:"), ev.Err)
+		case "done":
+			if ev.Usage != nil {
+				s.totalTokens += ev.Usage.PromptTokens + ev.Usage.CompletionTokens
+			}
+		}
+	}
+}
+
+// fileSHA256 hashes path's contents, used to fold uploaded documents
+// into the chat cache key so a cached reply is only reused while the
+// same documents are in scope.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func truncateToolOutput(output string) string {
+	if len(output) <= maxInlineToolOutput {
+		return output
+	}
+	return output[:maxInlineToolOutput] + "..."
+}
+
+// handleSlashCommand runs a client-side slash-command. It returns
+// handled=false for anything not recognized, so the caller can decide
+// whether to send the line to the server as a literal prompt instead.
+func (s *chatSession) handleSlashCommand(line string) (handled bool, err error) {
+	fields := strings.Fields(line)
+	cmd, rest := fields[0], fields[1:]
+
+	switch cmd {
+	case "/show":
+		if len(rest) != 1 {
+			return true, fmt.Errorf("usage: /show <n>")
+		}
+		n, err := strconv.Atoi(rest[0])
+		if err != nil || n < 1 || n > len(s.toolOutputs) {
+			return true, fmt.Errorf("no such tool output: %s", rest[0])
+		}
+		fmt.Println(s.toolOutputs[n-1])
+		return true, nil
+
+	case "/upload":
+		if len(rest) != 1 {
+			return true, fmt.Errorf("usage: /upload <file>")
+		}
+		path, err := s.c.UploadDocument(s.ctx, user, s.session, rest[0], nil)
+		if err != nil {
+			return true, err
+		}
+		if hash, err := fileSHA256(rest[0]); err == nil {
+			s.docHashes = append(s.docHashes, hash)
+		}
+		fmt.Println("uploaded to", path)
+		return true, nil
+
+	case "/ls":
+		path := "/data"
+		if len(rest) == 1 {
+			path = rest[0]
+		}
+		entries, err := s.c.ListDir(s.ctx, user, path)
+		if err != nil {
+			return true, err
+		}
+		for _, e := range entries {
+			if e.IsDir {
+				fmt.Println(e.Name + "/")
+			} else {
+				fmt.Println(e.Name)
+			}
+		}
+		return true, nil
+
+	case "/cat":
+		if len(rest) != 1 {
+			return true, fmt.Errorf("usage: /cat <path>")
+		}
+		content, err := s.c.ReadFile(s.ctx, user, rest[0])
+		if err != nil {
+			return true, err
+		}
+		fmt.Print(content)
+		return true, nil
+
+	case "/rm":
+		if len(rest) != 1 {
+			return true, fmt.Errorf("usage: /rm <path>")
+		}
+		return true, s.c.DeleteFile(s.ctx, user, rest[0])
+
+	case "/session":
+		return true, s.handleSessionCommand(rest)
+
+	case "/model":
+		if len(rest) != 1 {
+			return true, fmt.Errorf("usage: /model <name>")
+		}
+		s.model = rest[0]
+		fmt.Println("model set to", s.model)
+		return true, nil
+
+	case "/system":
+		s.system = strings.Join(rest, " ")
+		fmt.Println("system prompt set")
+		return true, nil
+
+	case "/save":
+		if len(rest) != 1 {
+			return true, fmt.Errorf("usage: /save <file>")
+		}
+		return true, s.saveTranscript(rest[0])
+
+	case "/retry":
+		if s.lastPrompt == "" {
+			return true, fmt.Errorf("nothing to retry")
+		}
+		s.sendPrompt(s.lastPrompt)
+		return true, nil
+
+	}
+
+	return false, nil
+}
+
+func (s *chatSession) handleSessionCommand(rest []string) error {
+	if len(rest) == 0 {
+		return fmt.Errorf("usage: /session new|switch|rename|delete <name>")
+	}
+	switch rest[0] {
+	case "new":
+		if len(rest) != 2 {
+			return fmt.Errorf("usage: /session new <name>")
+		}
+		s.session = rest[1]
+		fmt.Println("switched to new session", s.session)
+		return nil
+	case "switch":
+		if len(rest) != 2 {
+			return fmt.Errorf("usage: /session switch <name>")
+		}
+		s.session = rest[1]
+		fmt.Println("switched to session", s.session)
+		return nil
+	case "rename":
+		if len(rest) != 2 {
+			return fmt.Errorf("usage: /session rename <name>")
+		}
+		return fmt.Errorf("/session rename is not supported: the backend has no rename-session call")
+	case "delete":
+		if len(rest) != 2 {
+			return fmt.Errorf("usage: /session delete <name>")
+		}
+		return fmt.Errorf("/session delete is not supported: the backend has no delete-session call")
+	}
+	return fmt.Errorf("unknown /session subcommand: %s", rest[0])
+}
+
+// saveTranscript dumps the conversation so far as Markdown.
+func (s *chatSession) saveTranscript(path string) error {
+	var b strings.Builder
+	for _, e := range s.transcript {
+		fmt.Fprintf(&b, "## You\n\n%s\n\n## Assistant\n\n%s\n\n", e.prompt, e.reply.String())
+	}
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// newCompleter builds a readline AutoCompleter that completes slash
+// commands at the start of the line and, for /ls, /cat and /rm, the
+// names of the entries directly under /data, fetched live via
+// client.ListDir. Subdirectories are not descended into.
+func newCompleter(ctx context.Context, c *client.Client) readline.AutoCompleter {
+	pathItems := func(line string) []string {
+		dir := "/data"
+		entries, err := c.ListDir(ctx, user, dir)
+		if err != nil {
+			return nil
+		}
+		names := make([]string, 0, len(entries))
+		for _, e := range entries {
+			names = append(names, e.Name)
+		}
+		return names
+	}
+
+	return readline.NewPrefixCompleter(
+		readline.PcItem("/upload"),
+		readline.PcItem("/ls", readline.PcItemDynamic(pathItems)),
+		readline.PcItem("/cat", readline.PcItemDynamic(pathItems)),
+		readline.PcItem("/rm", readline.PcItemDynamic(pathItems)),
+		readline.PcItem("/session",
+			readline.PcItem("new"), readline.PcItem("switch"),
+			readline.PcItem("rename"), readline.PcItem("delete"),
+		),
+		readline.PcItem("/model"),
+		readline.PcItem("/system"),
+		readline.PcItem("/save"),
+		readline.PcItem("/retry"),
+		readline.PcItem("/show"),
+	)
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newDownloadCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "download <path> <file>",
+		Short: "Download a file from the VM, resuming a partial local copy if present",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			c := newClient()
+
+			progress, bar := newProgressFunc(0)
+			err := c.DownloadFile(ctx, user, args[0], args[1], progress)
+			if bar != nil {
+				bar.Finish()
+			}
+			if err != nil {
+				return err
+			}
+			if !silent {
+				fmt.Println("Downloaded to", args[1])
+			}
+			return nil
+		},
+	}
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-
-	"llm-cli/internal/client"
 )
 
 func newLsCmd() *cobra.Command {
@@ -19,7 +17,7 @@ func newLsCmd() *cobra.Command {
 			if len(args) == 1 {
 				path = args[0]
 			}
-			c := client.New(server)
+			c := newClient()
 			entries, err := c.ListDir(context.Background(), user, path)
 			if err != nil {
 				return err
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"llm-cli/internal/fuse"
+)
+
+func newMountCmd() *cobra.Command {
+	var readOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "mount <mountpoint>",
+		Short: "Mount the VM filesystem locally over FUSE",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			c := newClient()
+			mounter := fuse.NewMounter(c, user, readOnly)
+
+			ready := make(chan struct{})
+			go func() {
+				<-ready
+				fmt.Printf("mounted %s for user %s (readonly=%v)\n", args[0], user, readOnly)
+			}()
+
+			return mounter.Mount(ctx, args[0], ready)
+		},
+	}
+
+	cmd.Flags().BoolVar(&readOnly, "readonly", false, "mount the filesystem read-only")
+	return cmd
+}
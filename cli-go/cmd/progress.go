@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/cheggaaa/pb/v3"
+	"golang.org/x/term"
+
+	"llm-cli/internal/client"
+)
+
+var (
+	silent     bool
+	noProgress bool
+)
+
+// wantProgress reports whether a progress bar should be drawn for the
+// current invocation: stdout must be a TTY and neither --silent nor
+// --no-progress was passed.
+func wantProgress() bool {
+	if silent || noProgress {
+		return false
+	}
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// newProgressFunc returns a client.ProgressFunc that drives a pb bar
+// sized to total, or nil if progress output isn't wanted. If total is 0
+// (unknown up front, as with a download before its first byte range
+// comes back), the bar's total is set from the first progress callback
+// that reports one.
+func newProgressFunc(total int64) (client.ProgressFunc, *pb.ProgressBar) {
+	if !wantProgress() {
+		return nil, nil
+	}
+	bar := pb.Full.Start64(total)
+	bar.Set(pb.Bytes, true)
+	knownTotal := total
+	return func(done, total int64) {
+		if knownTotal == 0 && total > 0 {
+			bar.SetTotal(total)
+			knownTotal = total
+		}
+		bar.SetCurrent(done)
+	}, bar
+}
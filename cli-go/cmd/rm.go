@@ -4,8 +4,6 @@ import (
 	"context"
 
 	"github.com/spf13/cobra"
-
-	"llm-cli/internal/client"
 )
 
 func newRmCmd() *cobra.Command {
@@ -14,7 +12,7 @@ func newRmCmd() *cobra.Command {
 		Short: "Remove a file or directory in the VM",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			c := client.New(server)
+			c := newClient()
 			return c.DeleteFile(context.Background(), user, args[0])
 		},
 	}
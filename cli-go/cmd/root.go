@@ -3,15 +3,57 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+
+	"llm-cli/internal/cache"
+	"llm-cli/internal/client"
+	clientgrpc "llm-cli/internal/client/grpc"
 )
 
 var (
-	server string
-	user   string
+	server    string
+	user      string
+	transport string
+	cacheFlag string
 )
 
+// newClient builds a client.Client using whichever transport --transport
+// selected and, unless --cache=off, a local response cache. A bad
+// --transport value, a gRPC client that can't be created, a bad --cache
+// value, or a cache directory that can't be opened all fall back to a
+// safe default (HTTP transport, no cache) rather than failing every
+// subcommand. The gRPC connection is made lazily, so an unreachable
+// gRPC server is not detected here and does not trigger the fallback.
+func newClient() *client.Client {
+	var opts []client.Option
+
+	if transport == "grpc" {
+		target := strings.TrimPrefix(strings.TrimPrefix(server, "http://"), "https://")
+		if t, err := clientgrpc.New(target); err != nil {
+			fmt.Fprintf(os.Stderr, "grpc transport unavailable (%v), falling back to http\n", err)
+		} else {
+			opts = append(opts, client.WithTransport(t))
+		}
+	}
+
+	mode, err := cache.ParseMode(cacheFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v, disabling cache\n", err)
+		mode = cache.ModeOff
+	}
+	if mode != cache.ModeOff {
+		if store, err := cache.Open(cache.DefaultDir(), cache.DefaultMaxBytes); err != nil {
+			fmt.Fprintf(os.Stderr, "cache unavailable (%v), disabling cache\n", err)
+		} else {
+			opts = append(opts, client.WithCache(store, mode))
+		}
+	}
+
+	return client.New(server, opts...)
+}
+
 func NewRootCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "llmcli",
@@ -20,13 +62,20 @@ func NewRootCmd() *cobra.Command {
 
 	cmd.PersistentFlags().StringVarP(&server, "server", "s", "http://localhost:8000", "API server URL")
 	cmd.PersistentFlags().StringVarP(&user, "user", "u", "default", "User name")
+	cmd.PersistentFlags().BoolVar(&silent, "silent", false, "suppress all non-essential output")
+	cmd.PersistentFlags().BoolVar(&noProgress, "no-progress", false, "disable progress bars")
+	cmd.PersistentFlags().StringVar(&transport, "transport", "http", "client transport: http or grpc")
+	cmd.PersistentFlags().StringVar(&cacheFlag, "cache", "on", "response cache: on, off, or refresh")
 
 	cmd.AddCommand(newChatCmd())
 	cmd.AddCommand(newUploadCmd())
+	cmd.AddCommand(newDownloadCmd())
 	cmd.AddCommand(newLsCmd())
 	cmd.AddCommand(newCatCmd())
 	cmd.AddCommand(newWriteCmd())
 	cmd.AddCommand(newRmCmd())
+	cmd.AddCommand(newMountCmd())
+	cmd.AddCommand(newCacheCmd())
 
 	return cmd
 }
@@ -3,10 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
-
-	"llm-cli/internal/client"
 )
 
 func newUploadCmd() *cobra.Command {
@@ -16,12 +15,24 @@ func newUploadCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
-			c := client.New(server)
-			path, err := c.UploadDocument(ctx, user, "default", args[0])
+			c := newClient()
+
+			var total int64
+			if fi, err := os.Stat(args[0]); err == nil {
+				total = fi.Size()
+			}
+			progress, bar := newProgressFunc(total)
+
+			path, err := c.UploadDocument(ctx, user, "default", args[0], progress)
+			if bar != nil {
+				bar.Finish()
+			}
 			if err != nil {
 				return err
 			}
-			fmt.Println("Uploaded to", path)
+			if !silent {
+				fmt.Println("Uploaded to", path)
+			}
 			return nil
 		},
 	}
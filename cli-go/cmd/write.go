@@ -5,10 +5,12 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-
-	"llm-cli/internal/client"
 )
 
+// newWriteCmd has no progress bar, unlike upload/download/cat: WriteFile
+// writes an arbitrary VM path in one request (unlike GetRange, there's
+// no chunked counterpart on the backend for it to drive real progress
+// off of), so a bar here would only ever jump straight to 100%.
 func newWriteCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "write <path> <file>",
@@ -19,7 +21,8 @@ func newWriteCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			c := client.New(server)
+
+			c := newClient()
 			return c.WriteFile(context.Background(), user, args[0], string(data))
 		},
 	}
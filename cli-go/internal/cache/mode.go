@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Mode selects how a Cache is consulted for a chat turn.
+type Mode string
+
+const (
+	ModeOn      Mode = "on"      // replay on hit, record on miss (default)
+	ModeOff     Mode = "off"     // bypass the cache entirely
+	ModeRefresh Mode = "refresh" // ignore any existing entry, always record a fresh one
+)
+
+// ParseMode validates a --cache flag value.
+func ParseMode(s string) (Mode, error) {
+	switch Mode(s) {
+	case ModeOn, ModeOff, ModeRefresh:
+		return Mode(s), nil
+	default:
+		return "", fmt.Errorf("invalid cache mode %q (want on, off, or refresh)", s)
+	}
+}
+
+// Key returns the content address for a chat turn: the SHA-256 hex
+// digest of everything that determines its output. prompt is expected
+// to already carry any session system-prompt prefix the caller applies,
+// so two turns that differ only in session name or timing hash
+// identically and a deterministic prompt hits the cache across
+// sessions. docHashes are sorted first so upload order doesn't matter.
+func Key(user, prompt, model string, docHashes []string) string {
+	sorted := append([]string(nil), docHashes...)
+	sort.Strings(sorted)
+
+	h := sha256.New()
+	fmt.Fprintln(h, user)
+	fmt.Fprintln(h, normalizePrompt(prompt))
+	fmt.Fprintln(h, model)
+	for _, d := range sorted {
+		fmt.Fprintln(h, d)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// normalizePrompt collapses whitespace so cosmetic differences (extra
+// spaces, trailing newlines) don't produce distinct cache keys.
+func normalizePrompt(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
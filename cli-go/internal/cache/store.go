@@ -0,0 +1,249 @@
+// Package cache provides an on-disk, content-addressed cache of chat
+// turns, so repeated prompts (evals, doc-QA over the same uploaded
+// files) can be replayed without round-tripping the backend. It has no
+// dependency on internal/client: callers convert to and from Event at
+// the boundary, the same way internal/client/grpc converts pb types
+// rather than internal/client importing pb.
+package cache
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultMaxBytes is the size cap newClient and the cache subcommands
+// open the store with; there is no flag to override it.
+const DefaultMaxBytes = 512 << 20 // 512 MiB
+
+// ToolEvent mirrors client.ToolEvent for on-disk storage.
+type ToolEvent struct {
+	Name   string `json:"name,omitempty"`
+	Args   string `json:"args,omitempty"`
+	Output string `json:"output,omitempty"`
+}
+
+// Usage mirrors client.Usage for on-disk storage.
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens,omitempty"`
+	CompletionTokens int `json:"completion_tokens,omitempty"`
+}
+
+// Event is the cache's on-disk representation of one client.ChatEvent.
+// Err is a string rather than an error so it round-trips through JSON.
+type Event struct {
+	Type  string     `json:"type"`
+	Text  string     `json:"text,omitempty"`
+	Tool  *ToolEvent `json:"tool,omitempty"`
+	Path  string     `json:"path,omitempty"`
+	Usage *Usage     `json:"usage,omitempty"`
+	Err   string     `json:"err,omitempty"`
+}
+
+// Cache is a sharded-file, content-addressed store of cached chat event
+// streams under dir. Entries are sharded two levels deep by the first
+// four hex characters of their key to keep any one directory small.
+type Cache struct {
+	dir      string
+	maxBytes int64
+}
+
+// DefaultDir returns ~/.cache/llmcli, falling back to a relative
+// ".llmcli-cache" if the home directory can't be determined.
+func DefaultDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".llmcli-cache"
+	}
+	return filepath.Join(home, ".cache", "llmcli")
+}
+
+// Open prepares dir for use as a cache store, creating it if needed.
+// maxBytes caps its on-disk size; Commit and Prune evict the
+// oldest-accessed entries first once it's exceeded.
+func Open(dir string, maxBytes int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Cache{dir: dir, maxBytes: maxBytes}, nil
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key[2:4], key+".ndjson")
+}
+
+// Get returns the events recorded for key, and whether a complete entry
+// was found at all. Any read or decode error is treated as a miss
+// rather than returned, since a corrupt cache entry should never fail a
+// chat turn.
+func (c *Cache) Get(key string) ([]Event, bool) {
+	p := c.path(key)
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, false
+	}
+	defer f.Close()
+
+	var events []Event
+	dec := json.NewDecoder(f)
+	for dec.More() {
+		var ev Event
+		if err := dec.Decode(&ev); err != nil {
+			return nil, false
+		}
+		events = append(events, ev)
+	}
+
+	now := time.Now()
+	os.Chtimes(p, now, now)
+	return events, true
+}
+
+// Recorder tees a live event stream to disk as it's consumed, writing
+// to a *.partial file that Commit renames into place. A turn that's
+// interrupted before Commit leaves only the stale .partial behind,
+// never a corrupt cache hit.
+type Recorder struct {
+	cache *Cache
+	tmp   *os.File
+	enc   *json.Encoder
+}
+
+// NewRecorder opens a .partial file for key. Call Write for each event
+// as it arrives, then Commit once the turn finishes or Abort if it's
+// canceled first.
+func (c *Cache) NewRecorder(key string) (*Recorder, error) {
+	final := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(final), 0755); err != nil {
+		return nil, err
+	}
+	tmp, err := os.Create(final + ".partial")
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{cache: c, tmp: tmp, enc: json.NewEncoder(tmp)}, nil
+}
+
+// Write appends one event to the partial file.
+func (r *Recorder) Write(ev Event) error {
+	return r.enc.Encode(ev)
+}
+
+// Commit renames the partial file into place and prunes the cache if
+// it's now over its size cap.
+func (r *Recorder) Commit() error {
+	if err := r.tmp.Close(); err != nil {
+		return err
+	}
+	final := strings.TrimSuffix(r.tmp.Name(), ".partial")
+	if err := os.Rename(r.tmp.Name(), final); err != nil {
+		return err
+	}
+	_, err := r.cache.evictToCap()
+	return err
+}
+
+// Abort discards the partial file without committing it.
+func (r *Recorder) Abort() error {
+	r.tmp.Close()
+	return os.Remove(r.tmp.Name())
+}
+
+// Entry describes one committed cache entry for List, Stats, and Prune.
+type Entry struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// List returns every committed entry (never a stale .partial), oldest
+// mtime (i.e. least recently read) first.
+func (c *Cache) List() ([]Entry, error) {
+	var entries []Entry
+	err := filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".ndjson") {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, Entry{
+			Key:     strings.TrimSuffix(d.Name(), ".ndjson"),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ModTime.Before(entries[j].ModTime) })
+	return entries, nil
+}
+
+// Stats summarizes the cache's on-disk footprint.
+type Stats struct {
+	Entries  int
+	Bytes    int64
+	MaxBytes int64
+}
+
+// Stats reports how many entries are cached and how much space they use.
+func (c *Cache) Stats() (Stats, error) {
+	entries, err := c.List()
+	if err != nil {
+		return Stats{}, err
+	}
+	var total int64
+	for _, e := range entries {
+		total += e.Size
+	}
+	return Stats{Entries: len(entries), Bytes: total, MaxBytes: c.maxBytes}, nil
+}
+
+// Remove deletes the entry for key, if any.
+func (c *Cache) Remove(key string) error {
+	err := os.Remove(c.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Prune evicts the oldest-accessed entries until the cache is at or
+// under its size cap, returning how many were removed.
+func (c *Cache) Prune() (int, error) {
+	return c.evictToCap()
+}
+
+func (c *Cache) evictToCap() (int, error) {
+	if c.maxBytes <= 0 {
+		return 0, nil
+	}
+	entries, err := c.List()
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, e := range entries {
+		total += e.Size
+	}
+
+	var removed int
+	for i := 0; total > c.maxBytes && i < len(entries); i++ {
+		if err := os.Remove(c.path(entries[i].Key)); err != nil {
+			continue
+		}
+		total -= entries[i].Size
+		removed++
+	}
+	return removed, nil
+}
@@ -1,127 +1,161 @@
+// Package client talks to the llmcli backend. Client itself only
+// orchestrates requests (chunked transfer, resume, progress reporting,
+// response caching); the actual wire protocol is delegated to a
+// Transport, selectable via New's options.
 package client
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
 	"io"
-	"mime/multipart"
-	"net/http"
 	"os"
 	"path/filepath"
 	"time"
+
+	"llm-cli/internal/cache"
 )
 
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
+	transport Transport
+	cache     *cache.Cache
+	cacheMode cache.Mode
 }
 
-func New(baseURL string) *Client {
-	return &Client{
-		baseURL:    baseURL,
-		httpClient: &http.Client{Timeout: 30 * time.Second},
-	}
-}
+// Option configures New.
+type Option func(*Client)
 
-type SessionInfo struct {
-	Sessions []string `json:"sessions"`
+// WithTransport overrides the default HTTP/JSON transport, e.g. with the
+// gRPC one from internal/client/grpc. Callers that want a non-default
+// transport construct it themselves (it may need its own dial/teardown)
+// and pass it in, rather than this package knowing about every
+// implementation.
+func WithTransport(t Transport) Option {
+	return func(c *Client) { c.transport = t }
 }
 
-func (c *Client) ListSessions(ctx context.Context, user string) ([]string, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
-		fmt.Sprintf("%s/sessions/%s", c.baseURL, user), nil)
-	if err != nil {
-		return nil, err
+// WithCache makes ChatStream consult store according to mode instead of
+// always hitting the transport. Callers open the store themselves (it
+// lives under a configurable directory with its own size cap) and pass
+// it in, the same way a non-default Transport is supplied.
+func WithCache(store *cache.Cache, mode cache.Mode) Option {
+	return func(c *Client) {
+		c.cache = store
+		c.cacheMode = mode
 	}
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("list sessions failed: %s", resp.Status)
-	}
-	var data SessionInfo
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return nil, err
+}
+
+// New builds a Client against baseURL using the default HTTP/JSON
+// transport, or whatever Transport opts overrides it with.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{transport: newHTTPTransport(baseURL)}
+	for _, opt := range opts {
+		opt(c)
 	}
-	return data.Sessions, nil
+	return c
 }
 
-type ChatRequest struct {
-	User    string `json:"user"`
-	Session string `json:"session"`
-	Prompt  string `json:"prompt"`
+func (c *Client) ListSessions(ctx context.Context, user string) ([]string, error) {
+	return c.transport.ListSessions(ctx, user)
 }
 
-func (c *Client) ChatStream(ctx context.Context, user, session, prompt string) (io.ReadCloser, error) {
-	body, err := json.Marshal(ChatRequest{User: user, Session: session, Prompt: prompt})
-	if err != nil {
-		return nil, err
+// ChatStream returns a channel of typed ChatEvents for the prompt. The
+// channel is closed once the backend signals the turn is done or the
+// context is canceled.
+//
+// If a cache was configured with WithCache, model and docHashes (the
+// SHA-256 of every document uploaded into session so far) join user and
+// prompt to form the turn's cache key: on a hit (unless mode is
+// ModeRefresh) the cached events are replayed without touching the
+// transport at all; on a miss the live stream is teed to disk as it's
+// consumed.
+func (c *Client) ChatStream(ctx context.Context, user, session, prompt, model string, docHashes []string) (<-chan ChatEvent, error) {
+	if c.cache == nil || c.cacheMode == cache.ModeOff {
+		return c.transport.ChatStream(ctx, user, session, prompt, model)
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/chat/stream", bytes.NewReader(body))
-	if err != nil {
-		return nil, err
+
+	key := cache.Key(user, prompt, model, docHashes)
+	if c.cacheMode != cache.ModeRefresh {
+		if events, ok := c.cache.Get(key); ok {
+			return replayCache(events), nil
+		}
 	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := c.httpClient.Do(req)
+
+	live, err := c.transport.ChatStream(ctx, user, session, prompt, model)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		defer resp.Body.Close()
-		b, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("chat failed: %s - %s", resp.Status, string(b))
-	}
-	return resp.Body, nil
+	return c.teeToCache(live, key), nil
 }
 
-type UploadResp struct {
-	Path string `json:"path"`
+func replayCache(events []cache.Event) <-chan ChatEvent {
+	out := make(chan ChatEvent, len(events))
+	for _, ev := range events {
+		out <- fromCacheEvent(ev)
+	}
+	close(out)
+	return out
 }
 
-func (c *Client) UploadDocument(ctx context.Context, user, session, path string) (string, error) {
-	file, err := os.Open(path)
+// teeToCache wraps live so every event read off it is also recorded to
+// the cache. The entry is committed once a "done" event closes out the
+// turn, and discarded otherwise (e.g. an error frame, or the caller's
+// context was canceled mid-stream, which the transports surface as an
+// "error" event) so a partial reply never becomes a cache hit.
+func (c *Client) teeToCache(live <-chan ChatEvent, key string) <-chan ChatEvent {
+	rec, err := c.cache.NewRecorder(key)
 	if err != nil {
-		return "", err
+		return live // caching is best-effort; never block the turn on it
 	}
-	defer file.Close()
 
-	buf := &bytes.Buffer{}
-	writer := multipart.NewWriter(buf)
-	_ = writer.WriteField("user", user)
-	_ = writer.WriteField("session", session)
-	fw, err := writer.CreateFormFile("file", filepath.Base(path))
-	if err != nil {
-		return "", err
+	out := make(chan ChatEvent)
+	go func() {
+		defer close(out)
+		done := false
+		for ev := range live {
+			rec.Write(toCacheEvent(ev))
+			out <- ev
+			if ev.Type == "done" {
+				done = true
+			}
+		}
+		if done {
+			rec.Commit()
+		} else {
+			rec.Abort()
+		}
+	}()
+	return out
+}
+
+func toCacheEvent(ev ChatEvent) cache.Event {
+	out := cache.Event{Type: ev.Type, Text: ev.Text, Path: ev.Path}
+	if ev.Tool != nil {
+		out.Tool = &cache.ToolEvent{Name: ev.Tool.Name, Args: ev.Tool.Args, Output: ev.Tool.Output}
 	}
-	if _, err = io.Copy(fw, file); err != nil {
-		return "", err
+	if ev.Usage != nil {
+		out.Usage = &cache.Usage{PromptTokens: ev.Usage.PromptTokens, CompletionTokens: ev.Usage.CompletionTokens}
 	}
-	writer.Close()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/upload", buf)
-	if err != nil {
-		return "", err
+	if ev.Err != nil {
+		out.Err = ev.Err.Error()
 	}
-	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return out
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return "", err
+func fromCacheEvent(ev cache.Event) ChatEvent {
+	out := ChatEvent{Type: ev.Type, Text: ev.Text, Path: ev.Path}
+	if ev.Tool != nil {
+		out.Tool = &ToolEvent{Name: ev.Tool.Name, Args: ev.Tool.Args, Output: ev.Tool.Output}
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		b, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("upload failed: %s - %s", resp.Status, string(b))
+	if ev.Usage != nil {
+		out.Usage = &Usage{PromptTokens: ev.Usage.PromptTokens, CompletionTokens: ev.Usage.CompletionTokens}
 	}
-	var out UploadResp
-	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return "", err
+	if ev.Err != "" {
+		out.Err = errors.New(ev.Err)
 	}
-	return out.Path, nil
+	return out
 }
 
 type DirEntry struct {
@@ -129,90 +163,162 @@ type DirEntry struct {
 	IsDir bool   `json:"is_dir"`
 }
 
-type DirList struct {
-	Entries []DirEntry `json:"entries"`
+func (c *Client) ListDir(ctx context.Context, user, path string) ([]DirEntry, error) {
+	return c.transport.ListDir(ctx, user, path)
 }
 
-func (c *Client) ListDir(ctx context.Context, user, path string) ([]DirEntry, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
-		fmt.Sprintf("%s/vm/%s/list?path=%s", c.baseURL, user, path), nil)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("list dir failed: %s", resp.Status)
-	}
-	var out DirList
-	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return nil, err
-	}
-	return out.Entries, nil
+func (c *Client) ReadFile(ctx context.Context, user, path string) (string, error) {
+	return c.transport.ReadFile(ctx, user, path)
 }
 
-type FileContent struct {
-	Content string `json:"content"`
+func (c *Client) WriteFile(ctx context.Context, user, path, content string) error {
+	return c.transport.WriteFile(ctx, user, path, content)
 }
 
-func (c *Client) ReadFile(ctx context.Context, user, path string) (string, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
-		fmt.Sprintf("%s/vm/%s/file?path=%s", c.baseURL, user, path), nil)
+func (c *Client) DeleteFile(ctx context.Context, user, path string) error {
+	return c.transport.DeleteFile(ctx, user, path)
+}
+
+func (c *Client) Mkdir(ctx context.Context, user, path string) error {
+	return c.transport.Mkdir(ctx, user, path)
+}
+
+func (c *Client) Rename(ctx context.Context, user, oldPath, newPath string) error {
+	return c.transport.Rename(ctx, user, oldPath, newPath)
+}
+
+type FileInfo struct {
+	Name    string    `json:"name"`
+	IsDir   bool      `json:"is_dir"`
+	Size    int64     `json:"size"`
+	Mode    uint32    `json:"mode"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+func (c *Client) Stat(ctx context.Context, user, path string) (*FileInfo, error) {
+	return c.transport.Stat(ctx, user, path)
+}
+
+// chunkSize is the fixed size used for both uploads and downloads. Large
+// documents are moved in fixed windows instead of being buffered whole,
+// so memory use stays flat regardless of file size and an interrupted
+// transfer only needs to resume the last unacknowledged chunk.
+const chunkSize = 4 << 20 // 4 MiB
+
+// ProgressFunc is called after each chunk of a transfer completes, with
+// the number of bytes moved so far and the total size of the transfer
+// (0 if the total is unknown).
+type ProgressFunc func(done, total int64)
+
+// UploadDocument streams path to the server in chunkSize windows,
+// resuming from the offset the transport last acknowledged if a
+// previous attempt was interrupted. progress may be nil.
+func (c *Client) UploadDocument(ctx context.Context, user, session, path string, progress ProgressFunc) (string, error) {
+	file, err := os.Open(path)
 	if err != nil {
 		return "", err
 	}
-	resp, err := c.httpClient.Do(req)
+	defer file.Close()
+
+	info, err := file.Stat()
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("read file failed: %s", resp.Status)
-	}
-	var out FileContent
-	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return "", err
-	}
-	return out.Content, nil
-}
+	total := info.Size()
+	name := filepath.Base(path)
 
-func (c *Client) WriteFile(ctx context.Context, user, path, content string) error {
-	data, _ := json.Marshal(map[string]string{"path": path, "content": content})
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
-		fmt.Sprintf("%s/vm/%s/file", c.baseURL, user), bytes.NewReader(data))
+	offset, err := c.transport.ResumeOffset(ctx, user, session, name)
 	if err != nil {
-		return err
+		return "", err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
+
+	hasher := sha256.New()
+	if offset > 0 {
+		if _, err := io.Copy(hasher, io.NewSectionReader(file, 0, offset)); err != nil {
+			return "", err
+		}
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			return "", err
+		}
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		b, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("write file failed: %s - %s", resp.Status, string(b))
+
+	var uploadedPath string
+	for offset < total {
+		n := int64(chunkSize)
+		if remaining := total - offset; remaining < n {
+			n = remaining
+		}
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(file, buf); err != nil {
+			return "", err
+		}
+		hasher.Write(buf)
+
+		out, err := c.transport.PutChunk(ctx, user, session, name, offset, total, buf, shaHex(hasher))
+		if err != nil {
+			return "", err
+		}
+		uploadedPath = out
+
+		offset += n
+		if progress != nil {
+			progress(offset, total)
+		}
 	}
-	return nil
+	return uploadedPath, nil
 }
 
-func (c *Client) DeleteFile(ctx context.Context, user, path string) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete,
-		fmt.Sprintf("%s/vm/%s/file?path=%s", c.baseURL, user, path), nil)
+// DownloadFile fetches path from the VM into dest, resuming from
+// whatever dest already contains (e.g. a previous interrupted run) by
+// requesting the remaining byte range from the transport.
+func (c *Client) DownloadFile(ctx context.Context, user, path, dest string, progress ProgressFunc) error {
+	var offset int64
+	if fi, err := os.Stat(dest); err == nil {
+		offset = fi.Size()
+	}
+
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
 		return err
 	}
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		b, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("delete file failed: %s - %s", resp.Status, string(b))
+
+	return c.readRange(ctx, user, path, offset, f, progress)
+}
+
+// ReadFileStream copies path's contents to w in chunkSize windows via
+// the same ranged-read path DownloadFile uses, so callers that don't
+// need a local file (e.g. printing straight to stdout) still get real
+// transfer progress instead of a whole-file read reported after the
+// fact. progress may be nil.
+func (c *Client) ReadFileStream(ctx context.Context, user, path string, w io.Writer, progress ProgressFunc) error {
+	return c.readRange(ctx, user, path, 0, w, progress)
+}
+
+// readRange drives GetRange in chunkSize windows starting at offset,
+// writing each chunk to w and reporting progress as it lands.
+func (c *Client) readRange(ctx context.Context, user, path string, offset int64, w io.Writer, progress ProgressFunc) error {
+	for {
+		data, total, err := c.transport.GetRange(ctx, user, path, offset, chunkSize)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		offset += int64(len(data))
+		if progress != nil {
+			progress(offset, total)
+		}
+		if int64(len(data)) < chunkSize {
+			return nil
+		}
 	}
-	return nil
+}
+
+func shaHex(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
 }
@@ -0,0 +1,225 @@
+// Package grpc implements client.Transport over gRPC, generated from
+// proto/llmcli.proto, as an alternative to the default HTTP/JSON
+// transport. It unlocks flow control, per-request cancellation via
+// context, and multiplexed uploads on a single connection.
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"llm-cli/internal/client"
+	"llm-cli/internal/client/grpc/pb"
+)
+
+// Transport is a client.Transport backed by a single gRPC connection.
+type Transport struct {
+	conn     *grpc.ClientConn
+	chat     pb.ChatClient
+	files    pb.FilesClient
+	sessions pb.SessionsClient
+}
+
+// New sets up a connection to target (host:port) and returns a
+// Transport. Call Close when done with it. The connection is made
+// lazily, so an unreachable server is reported by the first RPC, not
+// by New.
+func New(target string) (*Transport, error) {
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &Transport{
+		conn:     conn,
+		chat:     pb.NewChatClient(conn),
+		files:    pb.NewFilesClient(conn),
+		sessions: pb.NewSessionsClient(conn),
+	}, nil
+}
+
+func (t *Transport) Close() error {
+	return t.conn.Close()
+}
+
+func (t *Transport) ListSessions(ctx context.Context, user string) ([]string, error) {
+	resp, err := t.sessions.List(ctx, &pb.ListSessionsRequest{User: user})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Sessions, nil
+}
+
+// ChatStream opens the bidi ChatStream RPC, sends the single prompt
+// turn, and translates each ChatChunk into a client.ChatEvent on the
+// returned channel.
+func (t *Transport) ChatStream(ctx context.Context, user, session, prompt, model string) (<-chan client.ChatEvent, error) {
+	stream, err := t.chat.ChatStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&pb.ChatRequest{User: user, Session: session, Prompt: prompt, Model: model}); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	events := make(chan client.ChatEvent)
+	go func() {
+		defer close(events)
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				events <- client.ChatEvent{Type: "error", Err: err}
+				return
+			}
+			events <- toChatEvent(chunk)
+		}
+	}()
+	return events, nil
+}
+
+func toChatEvent(chunk *pb.ChatChunk) client.ChatEvent {
+	switch {
+	case chunk.GetToolCall() != nil:
+		tc := chunk.GetToolCall()
+		return client.ChatEvent{Type: "tool_call", Tool: &client.ToolEvent{Name: tc.Name, Args: tc.ArgsJson}}
+	case chunk.GetToolResult() != nil:
+		tr := chunk.GetToolResult()
+		return client.ChatEvent{Type: "tool_result", Tool: &client.ToolEvent{Name: tr.Name, Output: tr.Output}}
+	case chunk.GetFileWritten() != nil:
+		return client.ChatEvent{Type: "file_written", Path: chunk.GetFileWritten().Path}
+	case chunk.GetError() != "":
+		return client.ChatEvent{Type: "error", Err: fmt.Errorf("%s", chunk.GetError())}
+	case chunk.GetUsage() != nil:
+		usage := chunk.GetUsage()
+		return client.ChatEvent{
+			Type: "done",
+			Usage: &client.Usage{
+				PromptTokens:     int(usage.PromptTokens),
+				CompletionTokens: int(usage.CompletionTokens),
+			},
+		}
+	default:
+		return client.ChatEvent{Type: "token", Text: chunk.GetTextDelta()}
+	}
+}
+
+func (t *Transport) ListDir(ctx context.Context, user, path string) ([]client.DirEntry, error) {
+	resp, err := t.files.List(ctx, &pb.ListFilesRequest{User: user, Path: path})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]client.DirEntry, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		out = append(out, client.DirEntry{Name: e.Name, IsDir: e.IsDir})
+	}
+	return out, nil
+}
+
+func (t *Transport) ReadFile(ctx context.Context, user, path string) (string, error) {
+	data, _, err := t.GetRange(ctx, user, path, 0, 1<<31-1)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (t *Transport) WriteFile(ctx context.Context, user, path, content string) error {
+	_, err := t.PutChunk(ctx, user, "default", path, 0, int64(len(content)), []byte(content), "")
+	return err
+}
+
+func (t *Transport) DeleteFile(ctx context.Context, user, path string) error {
+	_, err := t.files.Delete(ctx, &pb.DeleteFileRequest{User: user, Path: path})
+	return err
+}
+
+func (t *Transport) Mkdir(ctx context.Context, user, path string) error {
+	_, err := t.files.Mkdir(ctx, &pb.MkdirRequest{User: user, Path: path})
+	return err
+}
+
+func (t *Transport) Rename(ctx context.Context, user, oldPath, newPath string) error {
+	_, err := t.files.Rename(ctx, &pb.RenameRequest{User: user, OldPath: oldPath, NewPath: newPath})
+	return err
+}
+
+func (t *Transport) Stat(ctx context.Context, user, path string) (*client.FileInfo, error) {
+	resp, err := t.files.Stat(ctx, &pb.StatRequest{User: user, Path: path})
+	if err != nil {
+		return nil, err
+	}
+	return &client.FileInfo{
+		Name:    resp.Name,
+		IsDir:   resp.IsDir,
+		Size:    resp.Size,
+		Mode:    resp.Mode,
+		ModTime: time.Unix(resp.ModTimeUnix, 0),
+	}, nil
+}
+
+// ResumeOffset is served by Stat on the gRPC side: the server reports
+// how many bytes of an in-progress upload it has already committed as
+// the file's current size.
+func (t *Transport) ResumeOffset(ctx context.Context, user, session, name string) (int64, error) {
+	info, err := t.Stat(ctx, user, name)
+	if err != nil {
+		return 0, nil
+	}
+	return info.Size, nil
+}
+
+func (t *Transport) PutChunk(ctx context.Context, user, session, name string, offset, total int64, data []byte, sha256Hex string) (string, error) {
+	stream, err := t.files.Put(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := stream.Send(&pb.PutFileRequest{
+		User:        user,
+		Session:     session,
+		Name:        name,
+		OffsetBytes: offset,
+		TotalSize:   total,
+		Data:        data,
+		Sha256SoFar: sha256Hex,
+	}); err != nil {
+		return "", err
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", err
+	}
+	return resp.Path, nil
+}
+
+func (t *Transport) GetRange(ctx context.Context, user, path string, offset, size int64) ([]byte, int64, error) {
+	stream, err := t.files.Get(ctx, &pb.GetFileRequest{User: user, Path: path, OffsetBytes: offset, SizeBytes: size})
+	if err != nil {
+		return nil, 0, err
+	}
+	var data []byte
+	var total int64
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		data = append(data, chunk.Data...)
+		total = chunk.TotalSize
+	}
+	return data, total, nil
+}
+
+var _ client.Transport = (*Transport)(nil)
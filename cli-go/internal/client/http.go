@@ -0,0 +1,422 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+)
+
+// httpTransport is the original HTTP/JSON Transport implementation.
+type httpTransport struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func newHTTPTransport(baseURL string) *httpTransport {
+	return &httpTransport{
+		baseURL:    baseURL,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type SessionInfo struct {
+	Sessions []string `json:"sessions"`
+}
+
+func (t *httpTransport) ListSessions(ctx context.Context, user string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/sessions/%s", t.baseURL, user), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("list sessions failed: %s", resp.Status)
+	}
+	var data SessionInfo
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+	return data.Sessions, nil
+}
+
+type ChatRequest struct {
+	User    string `json:"user"`
+	Session string `json:"session"`
+	Prompt  string `json:"prompt"`
+	Model   string `json:"model,omitempty"`
+}
+
+// chatFrame is the wire shape of one /chat/stream NDJSON line, documented
+// as: {"type":"token","text":...}, {"type":"tool_call","name":...,
+// "args":...}, {"type":"tool_result","name":...,"output":...},
+// {"type":"file_written","path":...}, {"type":"error","message":...},
+// {"type":"done","usage":{...}}.
+type chatFrame struct {
+	Type    string `json:"type"`
+	Text    string `json:"text,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Args    string `json:"args,omitempty"`
+	Output  string `json:"output,omitempty"`
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message,omitempty"`
+	Usage   *Usage `json:"usage,omitempty"`
+}
+
+// ChatStream posts the prompt and parses the NDJSON response body into
+// a channel of typed ChatEvents, one per frame.
+func (t *httpTransport) ChatStream(ctx context.Context, user, session, prompt, model string) (<-chan ChatEvent, error) {
+	body, err := json.Marshal(ChatRequest{User: user, Session: session, Prompt: prompt, Model: model})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.baseURL+"/chat/stream", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/x-ndjson")
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("chat failed: %s - %s", resp.Status, string(b))
+	}
+
+	events := make(chan ChatEvent)
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+		r := bufio.NewReader(resp.Body)
+		for {
+			line, err := r.ReadBytes('\n')
+			line = bytes.TrimSpace(line)
+			if len(line) > 0 {
+				if ev, ok := parseChatFrame(line); ok {
+					events <- ev
+				}
+			}
+			if err != nil {
+				if err != io.EOF {
+					events <- ChatEvent{Type: "error", Err: err}
+				}
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+func parseChatFrame(line []byte) (ChatEvent, bool) {
+	// SSE servers prefix each frame with "data: "; strip it if present
+	// so the same parser handles SSE and bare NDJSON.
+	line = bytes.TrimPrefix(line, []byte("data: "))
+
+	var f chatFrame
+	if err := json.Unmarshal(line, &f); err != nil {
+		return ChatEvent{}, false
+	}
+	switch f.Type {
+	case "token":
+		return ChatEvent{Type: "token", Text: f.Text}, true
+	case "tool_call":
+		return ChatEvent{Type: "tool_call", Tool: &ToolEvent{Name: f.Name, Args: f.Args}}, true
+	case "tool_result":
+		return ChatEvent{Type: "tool_result", Tool: &ToolEvent{Name: f.Name, Output: f.Output}}, true
+	case "file_written":
+		return ChatEvent{Type: "file_written", Path: f.Path}, true
+	case "error":
+		return ChatEvent{Type: "error", Err: fmt.Errorf("%s", f.Message)}, true
+	case "done":
+		return ChatEvent{Type: "done", Usage: f.Usage}, true
+	default:
+		return ChatEvent{}, false
+	}
+}
+
+type UploadResp struct {
+	Path string `json:"path"`
+}
+
+type DirList struct {
+	Entries []DirEntry `json:"entries"`
+}
+
+func (t *httpTransport) ListDir(ctx context.Context, user, path string) ([]DirEntry, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/vm/%s/list?path=%s", t.baseURL, user, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("list dir failed: %s", resp.Status)
+	}
+	var out DirList
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out.Entries, nil
+}
+
+type FileContent struct {
+	Content string `json:"content"`
+}
+
+func (t *httpTransport) ReadFile(ctx context.Context, user, path string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/vm/%s/file?path=%s", t.baseURL, user, path), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("read file failed: %s", resp.Status)
+	}
+	var out FileContent
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	return out.Content, nil
+}
+
+func (t *httpTransport) WriteFile(ctx context.Context, user, path, content string) error {
+	data, _ := json.Marshal(map[string]string{"path": path, "content": content})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/vm/%s/file", t.baseURL, user), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("write file failed: %s - %s", resp.Status, string(b))
+	}
+	return nil
+}
+
+func (t *httpTransport) DeleteFile(ctx context.Context, user, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete,
+		fmt.Sprintf("%s/vm/%s/file?path=%s", t.baseURL, user, path), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("delete file failed: %s - %s", resp.Status, string(b))
+	}
+	return nil
+}
+
+func (t *httpTransport) Mkdir(ctx context.Context, user, path string) error {
+	data, _ := json.Marshal(map[string]string{"path": path})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/vm/%s/mkdir", t.baseURL, user), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("mkdir failed: %s - %s", resp.Status, string(b))
+	}
+	return nil
+}
+
+func (t *httpTransport) Rename(ctx context.Context, user, oldPath, newPath string) error {
+	data, _ := json.Marshal(map[string]string{"old_path": oldPath, "new_path": newPath})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/vm/%s/rename", t.baseURL, user), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("rename failed: %s - %s", resp.Status, string(b))
+	}
+	return nil
+}
+
+func (t *httpTransport) Stat(ctx context.Context, user, path string) (*FileInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/vm/%s/stat?path=%s", t.baseURL, user, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("stat failed: %s", resp.Status)
+	}
+	var out FileInfo
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResumeOffset asks the server how much of name it has already
+// committed for this user/session, via a HEAD probe on the chunk
+// endpoint. A fresh upload reports 0.
+func (t *httpTransport) ResumeOffset(ctx context.Context, user, session, name string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead,
+		fmt.Sprintf("%s/upload/chunk?user=%s&session=%s&name=%s", t.baseURL, user, session, name), nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return 0, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("resume probe failed: %s", resp.Status)
+	}
+	var offset int64
+	if _, err := fmt.Sscanf(resp.Header.Get("X-Committed-Offset"), "%d", &offset); err != nil {
+		return 0, nil
+	}
+	return offset, nil
+}
+
+// PutChunk streams one chunk via an io.Pipe + multipart writer rather
+// than buffering it, and sends the running SHA-256 of the file from
+// byte 0 through the end of this chunk as a trailer for the server to
+// verify.
+func (t *httpTransport) PutChunk(ctx context.Context, user, session, name string, offset, total int64, data []byte, sha256Hex string) (string, error) {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		var err error
+		defer func() {
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			pw.Close()
+		}()
+		if err = writer.WriteField("user", user); err != nil {
+			return
+		}
+		if err = writer.WriteField("session", session); err != nil {
+			return
+		}
+		var fw io.Writer
+		fw, err = writer.CreateFormFile("chunk", name)
+		if err != nil {
+			return
+		}
+		if _, err = fw.Write(data); err != nil {
+			return
+		}
+		err = writer.Close()
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.baseURL+"/upload/chunk", pr)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+int64(len(data))-1, total))
+	req.Trailer = http.Header{"X-Sha256": []string{sha256Hex}}
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("upload chunk failed: %s - %s", resp.Status, string(b))
+	}
+	var out UploadResp
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	return out.Path, nil
+}
+
+// GetRange fetches up to size bytes of path starting at offset, and
+// returns the total size of the remote file as reported by the
+// response's Content-Range header.
+func (t *httpTransport) GetRange(ctx context.Context, user, path string, offset, size int64) ([]byte, int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/vm/%s/file/range?path=%s", t.baseURL, user, path), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+size-1))
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, 0, fmt.Errorf("download failed: %s - %s", resp.Status, string(b))
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, size))
+	if err != nil {
+		return nil, 0, err
+	}
+	total, _ := parseContentRangeTotal(resp.Header.Get("Content-Range"))
+	return data, total, nil
+}
+
+func parseContentRangeTotal(cr string) (int64, error) {
+	var start, end, total int64
+	if _, err := fmt.Sscanf(cr, "bytes %d-%d/%d", &start, &end, &total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
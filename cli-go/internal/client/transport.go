@@ -0,0 +1,49 @@
+package client
+
+import "context"
+
+// ChatEvent is one frame of a chat stream, corresponding to one of the
+// documented /chat/stream frame types: token, tool_call, tool_result,
+// file_written, error, or done. Type discriminates which of the other
+// fields are populated.
+type ChatEvent struct {
+	Type  string
+	Text  string     // token
+	Tool  *ToolEvent // tool_call, tool_result
+	Path  string     // file_written
+	Usage *Usage     // done
+	Err   error      // error
+}
+
+// ToolEvent describes a tool call or its result within a ChatEvent.
+type ToolEvent struct {
+	Name   string
+	Args   string
+	Output string
+}
+
+// Usage reports token accounting for a completed chat turn.
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+// Transport is the wire-level implementation backing a Client: either
+// the original HTTP/JSON API or the gRPC one under internal/client/grpc.
+// Client itself only orchestrates (chunking, resume, progress); every
+// RPC goes through a Transport so the two can be swapped with
+// --transport=grpc|http without touching callers.
+type Transport interface {
+	ChatStream(ctx context.Context, user, session, prompt, model string) (<-chan ChatEvent, error)
+	ListSessions(ctx context.Context, user string) ([]string, error)
+	ListDir(ctx context.Context, user, path string) ([]DirEntry, error)
+	ReadFile(ctx context.Context, user, path string) (string, error)
+	WriteFile(ctx context.Context, user, path, content string) error
+	DeleteFile(ctx context.Context, user, path string) error
+	Mkdir(ctx context.Context, user, path string) error
+	Rename(ctx context.Context, user, oldPath, newPath string) error
+	Stat(ctx context.Context, user, path string) (*FileInfo, error)
+	ResumeOffset(ctx context.Context, user, session, name string) (int64, error)
+	PutChunk(ctx context.Context, user, session, name string, offset, total int64, data []byte, sha256Hex string) (string, error)
+	GetRange(ctx context.Context, user, path string, offset, size int64) ([]byte, int64, error)
+}
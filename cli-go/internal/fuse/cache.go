@@ -0,0 +1,68 @@
+package fuse
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// cacheKey identifies a cached file body by the triple that invalidates
+// it: a stale mtime is a cache miss even if user and path match.
+type cacheKey struct {
+	user  string
+	path  string
+	mtime time.Time
+}
+
+// readCache is a small in-memory LRU cache of whole file bodies, used to
+// avoid re-fetching a file over the wire for every read() syscall on it.
+type readCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[cacheKey]*list.Element
+}
+
+type cacheEntry struct {
+	key  cacheKey
+	data []byte
+}
+
+func newReadCache(capacity int) *readCache {
+	return &readCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[cacheKey]*list.Element),
+	}
+}
+
+func (c *readCache) get(key cacheKey) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*cacheEntry).data, true
+}
+
+func (c *readCache) put(key cacheKey, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*cacheEntry).data = data
+		return
+	}
+	el := c.ll.PushFront(&cacheEntry{key: key, data: data})
+	c.items[key] = el
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,83 @@
+// Package fuse exposes the remote VM filesystem as a local FUSE mount,
+// translating VFS operations into calls against client.Client.
+package fuse
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path"
+	"syscall"
+
+	"bazil.org/fuse"
+	fusefs "bazil.org/fuse/fs"
+
+	"llm-cli/internal/client"
+)
+
+// Mounter wraps a client.Client and mounts its remote tree as a local
+// FUSE filesystem, mirroring the way other VFS-over-API tools (e.g. the
+// Pachyderm fuse mounter) wrap an API client rather than reimplementing
+// storage locally.
+type Mounter struct {
+	c        *client.Client
+	user     string
+	readOnly bool
+	cache    *readCache
+}
+
+// NewMounter builds a Mounter for the given client and user. ReadOnly
+// rejects any Write/Mkdir/Rename/Delete operation at the FUSE layer.
+func NewMounter(c *client.Client, user string, readOnly bool) *Mounter {
+	return &Mounter{
+		c:        c,
+		user:     user,
+		readOnly: readOnly,
+		cache:    newReadCache(128),
+	}
+}
+
+// Mount blocks serving the filesystem at dir until the context is
+// canceled, a SIGINT is received, or the mount is unmounted out of band.
+// ready is closed once the mount is live, letting callers synchronize
+// startup (e.g. in tests or CLI progress output).
+func (m *Mounter) Mount(ctx context.Context, dir string, ready chan<- struct{}) error {
+	conn, err := fuse.Mount(dir, fuse.FSName("llmcli"), fuse.Subtype("llmclifs"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT)
+	defer signal.Stop(sigCh)
+
+	go func() {
+		select {
+		case <-sigCh:
+		case <-ctx.Done():
+		}
+		_ = fuse.Unmount(dir)
+	}()
+
+	if ready != nil {
+		close(ready)
+	}
+
+	if err := fusefs.Serve(conn, &rootFS{m: m}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// rootFS implements fusefs.FS, rooted at /data for the mounter's user.
+type rootFS struct{ m *Mounter }
+
+func (r *rootFS) Root() (fusefs.Node, error) {
+	return &dirNode{m: r.m, path: "/data"}, nil
+}
+
+func joinPath(base, name string) string {
+	return path.Join(base, name)
+}
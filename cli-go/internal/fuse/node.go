@@ -0,0 +1,184 @@
+package fuse
+
+import (
+	"context"
+	"os"
+	"syscall"
+
+	"bazil.org/fuse"
+	fusefs "bazil.org/fuse/fs"
+)
+
+// dirNode represents a directory in the mounted tree.
+type dirNode struct {
+	m    *Mounter
+	path string
+}
+
+func (d *dirNode) Attr(ctx context.Context, a *fuse.Attr) error {
+	a.Mode = os.ModeDir | 0755
+	return nil
+}
+
+func (d *dirNode) Lookup(ctx context.Context, name string) (fusefs.Node, error) {
+	child := joinPath(d.path, name)
+	info, err := d.m.c.Stat(ctx, d.m.user, child)
+	if err != nil {
+		return nil, fuse.ENOENT
+	}
+	if info.IsDir {
+		return &dirNode{m: d.m, path: child}, nil
+	}
+	return &fileNode{m: d.m, path: child, size: uint64(info.Size)}, nil
+}
+
+func (d *dirNode) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
+	entries, err := d.m.c.ListDir(ctx, d.m.user, d.path)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]fuse.Dirent, 0, len(entries))
+	for _, e := range entries {
+		typ := fuse.DT_File
+		if e.IsDir {
+			typ = fuse.DT_Dir
+		}
+		out = append(out, fuse.Dirent{Name: e.Name, Type: typ})
+	}
+	return out, nil
+}
+
+func (d *dirNode) Mkdir(ctx context.Context, req *fuse.MkdirRequest) (fusefs.Node, error) {
+	if d.m.readOnly {
+		return nil, fuse.Errno(syscall.EROFS)
+	}
+	child := joinPath(d.path, req.Name)
+	if err := d.m.c.Mkdir(ctx, d.m.user, child); err != nil {
+		return nil, err
+	}
+	return &dirNode{m: d.m, path: child}, nil
+}
+
+func (d *dirNode) Remove(ctx context.Context, req *fuse.RemoveRequest) error {
+	if d.m.readOnly {
+		return fuse.Errno(syscall.EROFS)
+	}
+	return d.m.c.DeleteFile(ctx, d.m.user, joinPath(d.path, req.Name))
+}
+
+func (d *dirNode) Rename(ctx context.Context, req *fuse.RenameRequest, newDir fusefs.Node) error {
+	if d.m.readOnly {
+		return fuse.Errno(syscall.EROFS)
+	}
+	dst, ok := newDir.(*dirNode)
+	if !ok {
+		return fuse.ENOTSUP
+	}
+	return d.m.c.Rename(ctx, d.m.user, joinPath(d.path, req.OldName), joinPath(dst.path, req.NewName))
+}
+
+func (d *dirNode) Create(ctx context.Context, req *fuse.CreateRequest, resp *fuse.CreateResponse) (fusefs.Node, fusefs.Handle, error) {
+	if d.m.readOnly {
+		return nil, nil, fuse.Errno(syscall.EROFS)
+	}
+	child := joinPath(d.path, req.Name)
+	if err := d.m.c.WriteFile(ctx, d.m.user, child, ""); err != nil {
+		return nil, nil, err
+	}
+	f := &fileNode{m: d.m, path: child, loaded: true}
+	return f, f, nil
+}
+
+// fileNode represents a regular file. Reads are served through the
+// mounter's LRU read cache; writes accumulate in buf and are only
+// flushed to the backend on Release, matching the write-back contract
+// most network filesystems use to batch small writes. buf is seeded
+// with the file's current remote contents on first Write after Open so
+// that a partial-offset write or append doesn't truncate the rest of
+// the file away.
+type fileNode struct {
+	m      *Mounter
+	path   string
+	size   uint64
+	buf    []byte
+	loaded bool
+	dirty  bool
+}
+
+func (f *fileNode) Attr(ctx context.Context, a *fuse.Attr) error {
+	info, err := f.m.c.Stat(ctx, f.m.user, f.path)
+	if err != nil {
+		return fuse.ENOENT
+	}
+	a.Mode = 0644
+	a.Size = uint64(info.Size)
+	a.Mtime = info.ModTime
+	return nil
+}
+
+func (f *fileNode) Open(ctx context.Context, req *fuse.OpenRequest, resp *fuse.OpenResponse) (fusefs.Handle, error) {
+	f.loaded = false
+	return f, nil
+}
+
+func (f *fileNode) Read(ctx context.Context, req *fuse.ReadRequest, resp *fuse.ReadResponse) error {
+	info, err := f.m.c.Stat(ctx, f.m.user, f.path)
+	if err != nil {
+		return err
+	}
+	key := cacheKey{user: f.m.user, path: f.path, mtime: info.ModTime}
+	data, ok := f.m.cache.get(key)
+	if !ok {
+		content, err := f.m.c.ReadFile(ctx, f.m.user, f.path)
+		if err != nil {
+			return err
+		}
+		data = []byte(content)
+		f.m.cache.put(key, data)
+	}
+	end := req.Offset + int64(req.Size)
+	if end > int64(len(data)) {
+		end = int64(len(data))
+	}
+	if req.Offset >= int64(len(data)) {
+		resp.Data = nil
+		return nil
+	}
+	resp.Data = data[req.Offset:end]
+	return nil
+}
+
+func (f *fileNode) Write(ctx context.Context, req *fuse.WriteRequest, resp *fuse.WriteResponse) error {
+	if f.m.readOnly {
+		return fuse.Errno(syscall.EROFS)
+	}
+	if !f.loaded {
+		content, err := f.m.c.ReadFile(ctx, f.m.user, f.path)
+		if err != nil {
+			return err
+		}
+		f.buf = []byte(content)
+		f.loaded = true
+	}
+	end := req.Offset + int64(len(req.Data))
+	if end > int64(len(f.buf)) {
+		grown := make([]byte, end)
+		copy(grown, f.buf)
+		f.buf = grown
+	}
+	copy(f.buf[req.Offset:], req.Data)
+	f.dirty = true
+	resp.Size = len(req.Data)
+	return nil
+}
+
+func (f *fileNode) Release(ctx context.Context, req *fuse.ReleaseRequest) error {
+	if !f.dirty {
+		return nil
+	}
+	if err := f.m.c.WriteFile(ctx, f.m.user, f.path, string(f.buf)); err != nil {
+		return err
+	}
+	f.dirty = false
+	return nil
+}